@@ -2,26 +2,40 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"math/rand"
 	"reflect"
+	"runtime"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	webappv1 "github.com/example/webapp-operator/api/v1"
+	ingresstranslate "github.com/example/webapp-operator/pkg/ingress"
 )
 
 const (
@@ -29,12 +43,83 @@ const (
 	defaultImage    = "nginx:1.21"
 	defaultReplicas = int32(2)
 	defaultPort     = int32(80)
+
+	// promoteAnnotation triggers promotion of the BlueGreen preview revision
+	// when set to "true" on the WebApp. The controller clears it once
+	// promotion completes so repeated promotions require repeated user
+	// action. It has no effect under the Canary strategy.
+	promoteAnnotation = "webapp.example.com/promote"
+
+	stableSuffix  = "-stable"
+	canarySuffix  = "-canary"
+	previewSuffix = "-preview"
+
+	// revisionLabel scopes a rollout revision's Deployment selector/pod
+	// template, and (for BlueGreen) the Service selector, to a single
+	// named revision, since the base labelsForWebApp selector alone matches
+	// every revision's pods.
+	revisionLabel = "webapp.example.com/revision"
+
+	// specHashAnnotation records a hash of the spec the operator last
+	// applied to a child resource. reconcileConfigMap, applyDeployment,
+	// reconcileService, reconcileNetworkPolicy and reconcileIngress compare
+	// against it instead of doing shallow field-by-field
+	// DeepEqual checks, so changes like labels, probes, or affinity that the
+	// old checks missed are no longer silently dropped.
+	specHashAnnotation = "webapp.example.com/spec-hash"
+
+	// fieldManager scopes server-side apply to the fields the operator
+	// actually sets, so it stops fighting an HPA mutating spec.replicas or a
+	// sidecar injector mutating the pod template.
+	fieldManager = "webapp-operator"
+
+	certManagerGroup   = "cert-manager.io"
+	certManagerVersion = "v1"
+)
+
+var certificateGVK = schema.GroupVersionKind{Group: certManagerGroup, Version: certManagerVersion, Kind: "Certificate"}
+
+var (
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "webapp_reconcile_duration_seconds",
+		Help: "Time spent reconciling a single child resource, by kind",
+	}, []string{"kind"})
+
+	childErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "webapp_child_errors_total",
+		Help: "Count of errors encountered reconciling a child resource, by kind",
+	}, []string{"kind"})
 )
 
+func init() {
+	ctrlmetrics.Registry.MustRegister(reconcileDuration, childErrorsTotal)
+}
+
+// newRateLimiter returns an exponential-backoff rate limiter, the same
+// family controller-runtime uses by default, with up to 20% random jitter
+// added to each computed delay so many WebApps failing at once (e.g. during
+// an API server disruption) don't all retry in lockstep.
+func newRateLimiter() workqueue.RateLimiter {
+	return jitteredRateLimiter{workqueue.NewItemExponentialFailureRateLimiter(time.Second, 5*time.Minute)}
+}
+
+type jitteredRateLimiter struct {
+	workqueue.RateLimiter
+}
+
+func (j jitteredRateLimiter) When(item interface{}) time.Duration {
+	delay := j.RateLimiter.When(item)
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
+
 // WebAppReconciler reconciles a WebApp object
 type WebAppReconciler struct {
 	client.Client
-	Scheme   *runtime.Scheme
+	Scheme   *k8sruntime.Scheme
+
+	// MaxConcurrentReconciles bounds how many WebApps this controller
+	// reconciles at once. Zero means SetupWithManager picks runtime.NumCPU().
+	MaxConcurrentReconciles int
 	Recorder record.EventRecorder
 }
 
@@ -45,6 +130,8 @@ type WebAppReconciler struct {
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 func (r *WebAppReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -88,32 +175,46 @@ func (r *WebAppReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Reconcile ConfigMap
-	if err := r.reconcileConfigMap(ctx, webApp); err != nil {
+	if err := r.reconcileChild(ctx, "ConfigMap", func() error { return r.reconcileConfigMap(ctx, webApp) }); err != nil {
 		logger.Error(err, "Failed to reconcile ConfigMap")
 		return ctrl.Result{}, err
 	}
 
-	// Reconcile Deployment
-	if err := r.reconcileDeployment(ctx, webApp); err != nil {
-		logger.Error(err, "Failed to reconcile Deployment")
+	// Reconcile Deployment(s) according to the configured rollout strategy
+	if err := r.reconcileChild(ctx, "Deployment", func() error { return r.reconcileRollout(ctx, webApp) }); err != nil {
+		logger.Error(err, "Failed to reconcile rollout")
 		return ctrl.Result{}, err
 	}
 
+	// Promote the BlueGreen preview revision when requested
+	if strategyType(webApp) == webappv1.BlueGreenStrategyType && webApp.Annotations[promoteAnnotation] == "true" {
+		if err := r.promoteBlueGreen(ctx, webApp); err != nil {
+			logger.Error(err, "Failed to promote BlueGreen revision")
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Reconcile Service
-	if err := r.reconcileService(ctx, webApp); err != nil {
+	if err := r.reconcileChild(ctx, "Service", func() error { return r.reconcileService(ctx, webApp) }); err != nil {
 		logger.Error(err, "Failed to reconcile Service")
 		return ctrl.Result{}, err
 	}
 
+	// Reconcile NetworkPolicy
+	if err := r.reconcileChild(ctx, "NetworkPolicy", func() error { return r.reconcileNetworkPolicy(ctx, webApp) }); err != nil {
+		logger.Error(err, "Failed to reconcile NetworkPolicy")
+		return ctrl.Result{}, err
+	}
+
 	// Reconcile Ingress if enabled
 	if webApp.Spec.Ingress != nil && webApp.Spec.Ingress.Enabled {
-		if err := r.reconcileIngress(ctx, webApp); err != nil {
+		if err := r.reconcileChild(ctx, "Ingress", func() error { return r.reconcileIngress(ctx, webApp) }); err != nil {
 			logger.Error(err, "Failed to reconcile Ingress")
 			return ctrl.Result{}, err
 		}
 	} else {
 		// Delete Ingress if it exists but is no longer needed
-		if err := r.deleteIngressIfExists(ctx, webApp); err != nil {
+		if err := r.reconcileChild(ctx, "Ingress", func() error { return r.deleteIngressIfExists(ctx, webApp) }); err != nil {
 			logger.Error(err, "Failed to delete Ingress")
 			return ctrl.Result{}, err
 		}
@@ -125,7 +226,23 @@ func (r *WebAppReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: time.Minute}, nil
+	// No fixed RequeueAfter: the Watches registered in SetupWithManager
+	// requeue us whenever ConfigMap/Deployment/Service/Ingress actually
+	// change, so there's nothing left to poll for.
+	return ctrl.Result{}, nil
+}
+
+// reconcileChild runs a single child resource's reconciliation, recording
+// its duration and, on failure, bumping the per-kind error counter so
+// operators can see which child resource is flaking across the fleet.
+func (r *WebAppReconciler) reconcileChild(ctx context.Context, kind string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	reconcileDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
+	if err != nil {
+		childErrorsTotal.WithLabelValues(kind).Inc()
+	}
+	return err
 }
 
 func (r *WebAppReconciler) finalizeWebApp(ctx context.Context, webApp *webappv1.WebApp) error {
@@ -136,161 +253,632 @@ func (r *WebAppReconciler) finalizeWebApp(ctx context.Context, webApp *webappv1.
 }
 
 func (r *WebAppReconciler) reconcileConfigMap(ctx context.Context, webApp *webappv1.WebApp) error {
-	logger := log.FromContext(ctx)
+	desired := r.configMapForWebApp(webApp)
+	return r.applyDesired(ctx, webApp, "ConfigMap", &corev1.ConfigMap{}, desired, desired.Data)
+}
 
-	configMap := &corev1.ConfigMap{}
-	err := r.Get(ctx, types.NamespacedName{Name: webApp.Name + "-config", Namespace: webApp.Namespace}, configMap)
+// reconcileRollout dispatches to the reconciler for the configured rollout
+// strategy, then deletes any Deployment a previously configured strategy
+// left behind. RollingUpdate (the default, a single Deployment) is handled
+// in-place; Canary and BlueGreen each manage a pair of Deployments and keep
+// the Service selector pointed at the right revision. Without the cleanup
+// step, switching strategies (e.g. RollingUpdate -> Canary) would leave the
+// old Deployment's pods still matching the Service selector alongside the
+// new revisions, serving traffic from a larger pod set than intended.
+func (r *WebAppReconciler) reconcileRollout(ctx context.Context, webApp *webappv1.WebApp) error {
+	var active []string
+	var err error
+
+	switch strategyType(webApp) {
+	case webappv1.CanaryStrategyType:
+		active = []string{webApp.Name + stableSuffix, webApp.Name + canarySuffix}
+		err = r.reconcileCanary(ctx, webApp)
+	case webappv1.BlueGreenStrategyType:
+		active = []string{webApp.Name, webApp.Name + previewSuffix}
+		err = r.reconcileBlueGreen(ctx, webApp)
+	default:
+		active = []string{webApp.Name}
+		err = r.reconcileRollingUpdate(ctx, webApp)
+	}
+	if err != nil {
+		return err
+	}
 
-	desiredConfigMap := r.configMapForWebApp(webApp)
+	return r.deleteStaleRolloutDeployments(ctx, webApp, active)
+}
 
+// deleteStaleRolloutDeployments deletes every Deployment name a rollout
+// strategy other than the currently configured one could have created, so
+// converging from one strategy to another doesn't orphan a Deployment that
+// still matches the Service selector.
+func (r *WebAppReconciler) deleteStaleRolloutDeployments(ctx context.Context, webApp *webappv1.WebApp, keep []string) error {
+	keepSet := make(map[string]bool, len(keep))
+	for _, name := range keep {
+		keepSet[name] = true
+	}
+
+	for _, name := range []string{webApp.Name, webApp.Name + stableSuffix, webApp.Name + canarySuffix, webApp.Name + previewSuffix} {
+		if keepSet[name] {
+			continue
+		}
+		if err := r.deleteDeploymentIfExists(ctx, webApp, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *WebAppReconciler) deleteDeploymentIfExists(ctx context.Context, webApp *webappv1.WebApp, name string) error {
+	logger := log.FromContext(ctx)
+
+	deployment := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: webApp.Namespace}, deployment)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			logger.Info("Creating a new ConfigMap", "ConfigMap.Namespace", desiredConfigMap.Namespace, "ConfigMap.Name", desiredConfigMap.Name)
-			err = r.Create(ctx, desiredConfigMap)
-			if err != nil {
-				return err
-			}
-			r.Recorder.Event(webApp, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created ConfigMap %s", desiredConfigMap.Name))
 			return nil
 		}
 		return err
 	}
 
-	// Update ConfigMap if needed
-	if !reflect.DeepEqual(configMap.Data, desiredConfigMap.Data) {
-		configMap.Data = desiredConfigMap.Data
-		err = r.Update(ctx, configMap)
-		if err != nil {
+	logger.Info("Deleting stale rollout Deployment", "Deployment.Namespace", deployment.Namespace, "Deployment.Name", deployment.Name)
+	if err := r.Delete(ctx, deployment); err != nil {
+		return err
+	}
+	r.Recorder.Event(webApp, corev1.EventTypeNormal, "Deleted", fmt.Sprintf("Deleted stale Deployment %s", deployment.Name))
+	return nil
+}
+
+func strategyType(webApp *webappv1.WebApp) webappv1.StrategyType {
+	if webApp.Spec.Strategy == nil {
+		return webappv1.RollingUpdateStrategyType
+	}
+	return webApp.Spec.Strategy.Type
+}
+
+func (r *WebAppReconciler) reconcileRollingUpdate(ctx context.Context, webApp *webappv1.WebApp) error {
+	return r.applyDeployment(ctx, webApp, r.deploymentForWebApp(webApp), false)
+}
+
+// reconcileCanary manages a pair of Deployments, "<name>-stable" and
+// "<name>-canary", and sizes them according to Spec.Strategy.Canary.Weight
+// (the percentage of replicas routed to the canary). Converging into Canary
+// from RollingUpdate creates the canary Deployment at zero replicas, so no
+// traffic shifts on that first pass; the reconcile its creation triggers
+// then scales it to its weighted share.
+func (r *WebAppReconciler) reconcileCanary(ctx context.Context, webApp *webappv1.WebApp) error {
+	total := defaultReplicas
+	if webApp.Spec.Replicas != nil {
+		total = *webApp.Spec.Replicas
+	}
+
+	weight := int32(0)
+	if webApp.Spec.Strategy != nil && webApp.Spec.Strategy.Canary != nil {
+		weight = webApp.Spec.Strategy.Canary.Weight
+	}
+
+	canaryReplicas := total * weight / 100
+	canary := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: webApp.Name + canarySuffix, Namespace: webApp.Namespace}, canary); err != nil {
+		if !errors.IsNotFound(err) {
 			return err
 		}
-		logger.Info("Updated ConfigMap", "ConfigMap.Namespace", configMap.Namespace, "ConfigMap.Name", configMap.Name)
-		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated ConfigMap %s", configMap.Name))
+		canaryReplicas = 0
+	}
+	stableReplicas := total - canaryReplicas
+
+	if err := r.reconcileRevisionDeployment(ctx, webApp, webApp.Name+stableSuffix, stableReplicas); err != nil {
+		return err
+	}
+	if err := r.reconcileRevisionDeployment(ctx, webApp, webApp.Name+canarySuffix, canaryReplicas); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reconcileBlueGreen manages an "active" Deployment, still named after the
+// WebApp for backward compatibility with RollingUpdate, and an inactive
+// "<name>-preview" Deployment. Promotion (triggered via the
+// promoteAnnotation) flips the Service selector's revisionLabel to the
+// preview revision's name and scales the former active Deployment down to
+// zero.
+func (r *WebAppReconciler) reconcileBlueGreen(ctx context.Context, webApp *webappv1.WebApp) error {
+	total := defaultReplicas
+	if webApp.Spec.Replicas != nil {
+		total = *webApp.Spec.Replicas
+	}
+
+	activeName, previewName := activeAndPreviewNames(webApp)
+
+	if err := r.reconcileRevisionDeployment(ctx, webApp, activeName, total); err != nil {
+		return err
+	}
+	// The inactive revision is kept at zero replicas until promoteBlueGreen
+	// flips Status.ActiveService to it, so the scale-down a promotion
+	// triggers stays durable instead of being reapplied back to total on
+	// the very next reconcile.
+	if err := r.reconcileRevisionDeployment(ctx, webApp, previewName, 0); err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func (r *WebAppReconciler) reconcileDeployment(ctx context.Context, webApp *webappv1.WebApp) error {
+// activeAndPreviewNames returns the Deployment names currently playing the
+// active and preview roles in a BlueGreen rollout, defaulting to
+// webApp.Name/webApp.Name+previewSuffix before the first promotion.
+func activeAndPreviewNames(webApp *webappv1.WebApp) (active, preview string) {
+	active = webApp.Name
+	if webApp.Status.ActiveService != "" {
+		active = webApp.Status.ActiveService
+	}
+	preview = webApp.Name + previewSuffix
+	if active == preview {
+		preview = webApp.Name
+	}
+	return active, preview
+}
+
+// reconcileRevisionDeployment creates or updates a Deployment for a single
+// rollout revision (stable, canary, or preview), overriding only its name
+// and replica count relative to the base desired Deployment.
+func (r *WebAppReconciler) reconcileRevisionDeployment(ctx context.Context, webApp *webappv1.WebApp, name string, replicas int32) error {
+	desired := r.deploymentForWebApp(webApp)
+	desired.Name = name
+	desired.Spec.Replicas = &replicas
+	desired.Spec.Selector.MatchLabels[revisionLabel] = name
+	desired.Spec.Template.Labels[revisionLabel] = name
+	return r.applyDeployment(ctx, webApp, desired, true)
+}
+
+// applyDeployment is the shared SSA/hash-comparison path for every
+// Deployment revision the operator manages (the single RollingUpdate
+// Deployment, or a Canary/BlueGreen revision). manageReplicas controls
+// whether the operator claims ownership of spec.replicas under server-side
+// apply: a Canary/BlueGreen revision's replica count is entirely
+// operator-computed (from Spec.Replicas and, for Canary,
+// Spec.Strategy.Canary.Weight), so it always
+// manages it, but the single RollingUpdate Deployment only sets it on
+// creation, afterwards omitting it from the applied config so an HPA
+// attached to the Deployment stays free to keep scaling it — otherwise the
+// next unrelated change (image, env, ...) would reapply the operator's
+// last-known replica count and fight the HPA.
+func (r *WebAppReconciler) applyDeployment(ctx context.Context, webApp *webappv1.WebApp, desired *appsv1.Deployment, manageReplicas bool) error {
+	if !manageReplicas {
+		existing := &appsv1.Deployment{}
+		err := r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			desired.Spec.Replicas = nil
+		}
+	}
+
+	return r.applyDesired(ctx, webApp, "Deployment", &appsv1.Deployment{}, desired, desired.Spec)
+}
+
+// promoteBlueGreen flips the active Service selector to the preview
+// revision, scales the previously active Deployment to zero, and clears the
+// promote annotation so a subsequent promotion must be requested again.
+func (r *WebAppReconciler) promoteBlueGreen(ctx context.Context, webApp *webappv1.WebApp) error {
 	logger := log.FromContext(ctx)
 
-	deployment := &appsv1.Deployment{}
-	err := r.Get(ctx, types.NamespacedName{Name: webApp.Name, Namespace: webApp.Namespace}, deployment)
+	activeName, previewName := activeAndPreviewNames(webApp)
 
-	desiredDeployment := r.deploymentForWebApp(webApp)
+	active := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: activeName, Namespace: webApp.Namespace}, active); err != nil {
+		return err
+	}
+
+	zero := int32(0)
+	active.Spec.Replicas = &zero
+	if err := r.Update(ctx, active); err != nil {
+		return err
+	}
+
+	webApp.Status.ActiveService = previewName
+	webApp.Status.PreviewService = activeName
+	if err := r.Status().Update(ctx, webApp); err != nil {
+		return err
+	}
+
+	delete(webApp.Annotations, promoteAnnotation)
+	if err := r.Update(ctx, webApp); err != nil {
+		return err
+	}
+
+	logger.Info("Promoted BlueGreen preview revision", "WebApp.Name", webApp.Name, "Active", previewName)
+	r.Recorder.Event(webApp, corev1.EventTypeNormal, "Promoted", fmt.Sprintf("Promoted %s to active", previewName))
+	return nil
+}
+
+func (r *WebAppReconciler) reconcileService(ctx context.Context, webApp *webappv1.WebApp) error {
+	desired := r.serviceForWebApp(webApp)
+	return r.applyDesired(ctx, webApp, "Service", &corev1.Service{}, desired, desired.Spec)
+}
+
+// reconcileNetworkPolicy creates or updates the NetworkPolicy locking down
+// ingress to the WebApp's pods, or deletes it when the subsystem has been
+// disabled. Following the toggle already used elsewhere for optional
+// components, a nil Spec.NetworkPolicy or an explicit IsDisabled() both mean
+// "don't manage a policy here" rather than "deny everything".
+func (r *WebAppReconciler) reconcileNetworkPolicy(ctx context.Context, webApp *webappv1.WebApp) error {
+	if webApp.Spec.NetworkPolicy == nil || webApp.Spec.NetworkPolicy.IsDisabled() {
+		return r.deleteNetworkPolicyIfExists(ctx, webApp)
+	}
+
+	desired := r.networkPolicyForWebApp(webApp)
+	return r.applyDesired(ctx, webApp, "NetworkPolicy", &networkingv1.NetworkPolicy{}, desired, desired.Spec)
+}
+
+func (r *WebAppReconciler) deleteNetworkPolicyIfExists(ctx context.Context, webApp *webappv1.WebApp) error {
+	logger := log.FromContext(ctx)
 
+	policy := &networkingv1.NetworkPolicy{}
+	err := r.Get(ctx, types.NamespacedName{Name: webApp.Name, Namespace: webApp.Namespace}, policy)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			logger.Info("Creating a new Deployment", "Deployment.Namespace", desiredDeployment.Namespace, "Deployment.Name", desiredDeployment.Name)
-			err = r.Create(ctx, desiredDeployment)
-			if err != nil {
-				return err
-			}
-			r.Recorder.Event(webApp, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created Deployment %s", desiredDeployment.Name))
 			return nil
 		}
 		return err
 	}
 
-	// Check if update is needed
-	needsUpdate := false
+	logger.Info("Deleting NetworkPolicy", "NetworkPolicy.Namespace", policy.Namespace, "NetworkPolicy.Name", policy.Name)
+	if err := r.Delete(ctx, policy); err != nil {
+		return err
+	}
+	r.Recorder.Event(webApp, corev1.EventTypeNormal, "Deleted", fmt.Sprintf("Deleted NetworkPolicy %s", policy.Name))
+	return nil
+}
+
+// networkPolicyForWebApp builds a NetworkPolicy that selects the WebApp's
+// pods, allows ingress only from namespaces/pods matching the configured
+// label selectors on the configured ports, and allows egress to kube-dns so
+// the pods can still resolve names under a default-deny policy.
+func (r *WebAppReconciler) networkPolicyForWebApp(webApp *webappv1.WebApp) *networkingv1.NetworkPolicy {
+	labels := labelsForWebApp(webApp.Name)
+	spec := webApp.Spec.NetworkPolicy
 
-	if *deployment.Spec.Replicas != *desiredDeployment.Spec.Replicas {
-		deployment.Spec.Replicas = desiredDeployment.Spec.Replicas
-		needsUpdate = true
+	ports := make([]networkingv1.NetworkPolicyPort, 0, len(spec.AllowedPorts))
+	for i := range spec.AllowedPorts {
+		port := intstr.FromInt(int(spec.AllowedPorts[i]))
+		protocol := corev1.ProtocolTCP
+		ports = append(ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &port})
 	}
 
-	if deployment.Spec.Template.Spec.Containers[0].Image != desiredDeployment.Spec.Template.Spec.Containers[0].Image {
-		deployment.Spec.Template.Spec.Containers[0].Image = desiredDeployment.Spec.Template.Spec.Containers[0].Image
-		needsUpdate = true
+	// An empty NetworkPolicyPeer{} (no ipBlock/podSelector/namespaceSelector
+	// set) isn't a valid "match nothing" peer, and an ingress rule with an
+	// empty or omitted From list means "allow from anywhere" - the opposite
+	// of what an operator configuring neither label selector would expect.
+	// So when neither is set, emit no ingress rule at all: with Ingress
+	// listed in PolicyTypes, that denies all ingress instead of silently
+	// opening it up.
+	var ingressRules []networkingv1.NetworkPolicyIngressRule
+	if len(spec.NamespaceLabels) > 0 || len(spec.FromLabels) > 0 {
+		peer := networkingv1.NetworkPolicyPeer{}
+		if len(spec.NamespaceLabels) > 0 {
+			peer.NamespaceSelector = &metav1.LabelSelector{MatchLabels: spec.NamespaceLabels}
+		}
+		if len(spec.FromLabels) > 0 {
+			peer.PodSelector = &metav1.LabelSelector{MatchLabels: spec.FromLabels}
+		}
+		ingressRules = append(ingressRules, networkingv1.NetworkPolicyIngressRule{
+			From:  []networkingv1.NetworkPolicyPeer{peer},
+			Ports: ports,
+		})
+	}
+
+	dnsPort53 := intstr.FromInt(53)
+	udp := corev1.ProtocolUDP
+	tcp := corev1.ProtocolTCP
+
+	policy := &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "NetworkPolicy"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      webApp.Name,
+			Namespace: webApp.Namespace,
+			Labels:    labels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: labels},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     ingressRules,
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To: []networkingv1.NetworkPolicyPeer{{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"kubernetes.io/metadata.name": "kube-system"},
+					},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &udp, Port: &dnsPort53},
+					{Protocol: &tcp, Port: &dnsPort53},
+				},
+			}},
+		},
 	}
 
-	if !reflect.DeepEqual(deployment.Spec.Template.Spec.Containers[0].Resources, desiredDeployment.Spec.Template.Spec.Containers[0].Resources) {
-		deployment.Spec.Template.Spec.Containers[0].Resources = desiredDeployment.Spec.Template.Spec.Containers[0].Resources
-		needsUpdate = true
+	controllerutil.SetControllerReference(webApp, policy, r.Scheme)
+	return policy
+}
+
+func (r *WebAppReconciler) reconcileIngress(ctx context.Context, webApp *webappv1.WebApp) error {
+	if err := r.reconcileIngressServicePorts(ctx, webApp); err != nil {
+		return err
 	}
 
-	if !reflect.DeepEqual(deployment.Spec.Template.Spec.Containers[0].Env, desiredDeployment.Spec.Template.Spec.Containers[0].Env) {
-		deployment.Spec.Template.Spec.Containers[0].Env = desiredDeployment.Spec.Template.Spec.Containers[0].Env
-		needsUpdate = true
+	if err := r.reconcileIngressCertificates(ctx, webApp); err != nil {
+		return err
 	}
 
-	if needsUpdate {
-		err = r.Update(ctx, deployment)
-		if err != nil {
-			return err
-		}
-		logger.Info("Updated Deployment", "Deployment.Namespace", deployment.Namespace, "Deployment.Name", deployment.Name)
-		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated Deployment %s", deployment.Name))
+	if translator, ok, err := r.translatorForWebApp(ctx, webApp); err != nil {
+		return err
+	} else if ok {
+		return r.reconcileTranslatedObjects(ctx, webApp, translator)
 	}
 
-	return nil
+	desired := r.ingressForWebApp(webApp)
+	return r.applyDesired(ctx, webApp, "Ingress", &networkingv1.Ingress{}, desired, ingressHashProjection{
+		Spec:        desired.Spec,
+		Annotations: desired.Annotations,
+	})
 }
 
-func (r *WebAppReconciler) reconcileService(ctx context.Context, webApp *webappv1.WebApp) error {
-	logger := log.FromContext(ctx)
-
-	service := &corev1.Service{}
-	err := r.Get(ctx, types.NamespacedName{Name: webApp.Name, Namespace: webApp.Namespace}, service)
+// ingressHashProjection is the part of a desired Ingress that
+// reconcileIngress hashes into specHashAnnotation. Provider annotations
+// (rate-limit, rewrite, canary weight, ...) carry just as much of the
+// desired state as Spec does, so an annotation-only change has to change
+// the hash too, or it would be silently skipped.
+type ingressHashProjection struct {
+	Spec        networkingv1.IngressSpec
+	Annotations map[string]string
+}
 
-	desiredService := r.serviceForWebApp(webApp)
+// reconcileIngressServicePorts ensures every port referenced by an Ingress
+// rule (by name) exists on the managed Service, adding it if the WebApp
+// author only declared it on the Ingress side.
+func (r *WebAppReconciler) reconcileIngressServicePorts(ctx context.Context, webApp *webappv1.WebApp) error {
+	if webApp.Spec.Ingress == nil || len(webApp.Spec.Ingress.Rules) == 0 {
+		return nil
+	}
 
-	if err != nil {
+	service := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Name: webApp.Name, Namespace: webApp.Namespace}, service); err != nil {
 		if errors.IsNotFound(err) {
-			logger.Info("Creating a new Service", "Service.Namespace", desiredService.Namespace, "Service.Name", desiredService.Name)
-			err = r.Create(ctx, desiredService)
-			if err != nil {
-				return err
-			}
-			r.Recorder.Event(webApp, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created Service %s", desiredService.Name))
 			return nil
 		}
 		return err
 	}
 
-	// Update Service if port changed
-	if service.Spec.Ports[0].Port != desiredService.Spec.Ports[0].Port {
-		service.Spec.Ports = desiredService.Spec.Ports
-		err = r.Update(ctx, service)
-		if err != nil {
+	existing := make(map[string]bool, len(service.Spec.Ports))
+	for _, p := range service.Spec.Ports {
+		existing[p.Name] = true
+	}
+
+	changed := false
+	for _, rule := range webApp.Spec.Ingress.Rules {
+		for _, path := range rule.Paths {
+			if path.PortName == "" || existing[path.PortName] {
+				continue
+			}
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+				Name:       path.PortName,
+				Port:       path.ServiceBackendRef.Port,
+				TargetPort: intstr.FromInt(int(path.ServiceBackendRef.Port)),
+				Protocol:   corev1.ProtocolTCP,
+			})
+			existing[path.PortName] = true
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := r.Update(ctx, service); err != nil {
 			return err
 		}
-		logger.Info("Updated Service", "Service.Namespace", service.Namespace, "Service.Name", service.Name)
-		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated Service %s", service.Name))
+		log.FromContext(ctx).Info("Added Ingress-referenced ports to Service", "Service.Name", service.Name)
 	}
 
 	return nil
 }
 
-func (r *WebAppReconciler) reconcileIngress(ctx context.Context, webApp *webappv1.WebApp) error {
+// reconcileIngressCertificates creates a cert-manager Certificate, via the
+// unstructured client so the operator does not take a hard dependency on
+// cert-manager's generated clientset, for every TLS entry that opts into
+// AutoGenerate.
+func (r *WebAppReconciler) reconcileIngressCertificates(ctx context.Context, webApp *webappv1.WebApp) error {
+	if webApp.Spec.Ingress == nil {
+		return nil
+	}
+
 	logger := log.FromContext(ctx)
 
-	ingress := &networkingv1.Ingress{}
-	err := r.Get(ctx, types.NamespacedName{Name: webApp.Name, Namespace: webApp.Namespace}, ingress)
+	for _, tls := range webApp.Spec.Ingress.TLS {
+		if !tls.AutoGenerate {
+			continue
+		}
 
-	desiredIngress := r.ingressForWebApp(webApp)
+		cert := &unstructured.Unstructured{}
+		cert.SetGroupVersionKind(certificateGVK)
+		name := types.NamespacedName{Name: tls.SecretName, Namespace: webApp.Namespace}
 
+		err := r.Get(ctx, name, cert)
+		if err == nil {
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+
+		cert = &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": certManagerGroup + "/" + certManagerVersion,
+				"kind":       "Certificate",
+				"metadata": map[string]interface{}{
+					"name":      tls.SecretName,
+					"namespace": webApp.Namespace,
+					"labels":    labelsForWebApp(webApp.Name),
+				},
+				"spec": map[string]interface{}{
+					"secretName": tls.SecretName,
+					"dnsNames":   tls.Hosts,
+					"issuerRef": map[string]interface{}{
+						"name": webApp.Spec.Ingress.IssuerName,
+						"kind": "ClusterIssuer",
+					},
+				},
+			},
+		}
+
+		if err := controllerutil.SetControllerReference(webApp, cert, r.Scheme); err != nil {
+			return err
+		}
+
+		logger.Info("Creating cert-manager Certificate", "Certificate.Namespace", webApp.Namespace, "Certificate.Name", tls.SecretName)
+		if err := r.Create(ctx, cert); err != nil {
+			return err
+		}
+		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created Certificate %s", tls.SecretName))
+	}
+
+	return nil
+}
+
+// hashObject returns a stable FNV-32a hash of the canonicalized JSON
+// projection of v, suitable for detecting whether a desired spec has
+// drifted from what was last applied.
+func hashObject(v interface{}) string {
+	data, err := json.Marshal(v)
 	if err != nil {
+		return ""
+	}
+	h := fnv.New32a()
+	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum32())
+}
+
+// applyDesired reconciles a single child resource by hashing spec (a
+// canonicalized JSON projection of the part of desired that matters, e.g.
+// its .Spec or .Data) into the specHashAnnotation and comparing against
+// what's already on the cluster, rather than field-by-field DeepEqual
+// checks. When the hash differs it patches desired onto the cluster via
+// server-side apply under fieldManager, so the operator only overwrites the
+// fields it owns and doesn't fight an HPA mutating spec.replicas or a
+// sidecar injector mutating the pod template. current must be an empty
+// pointer of the same concrete type as desired; it's used to fetch and
+// compare the existing object's hash annotation.
+func (r *WebAppReconciler) applyDesired(ctx context.Context, webApp *webappv1.WebApp, kind string, current, desired client.Object, spec interface{}) error {
+	logger := log.FromContext(ctx)
+
+	if err := controllerutil.SetControllerReference(webApp, desired, r.Scheme); err != nil {
+		return err
+	}
+
+	hash := hashObject(spec)
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[specHashAnnotation] = hash
+	desired.SetAnnotations(annotations)
+
+	key := types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()}
+	err := r.Get(ctx, key, current)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	if exists && current.GetAnnotations()[specHashAnnotation] == hash {
+		return nil
+	}
+
+	if err := r.Patch(ctx, desired, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership); err != nil {
+		return err
+	}
+
+	if exists {
+		logger.Info("Updated "+kind, kind+".Namespace", desired.GetNamespace(), kind+".Name", desired.GetName())
+		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", kind, desired.GetName()))
+	} else {
+		logger.Info("Created "+kind, kind+".Namespace", desired.GetNamespace(), kind+".Name", desired.GetName())
+		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", kind, desired.GetName()))
+	}
+	return nil
+}
+
+// translatorForWebApp resolves the IngressClass referenced by the WebApp and
+// looks up the Translator registered for its Spec.Controller string. The
+// second return value is false (with a nil error) when no IngressClassName
+// is set or no translator is registered for it, in which case the caller
+// should fall back to a vanilla Ingress.
+func (r *WebAppReconciler) translatorForWebApp(ctx context.Context, webApp *webappv1.WebApp) (ingresstranslate.Translator, bool, error) {
+	if webApp.Spec.Ingress.IngressClassName == nil || *webApp.Spec.Ingress.IngressClassName == "" {
+		return nil, false, nil
+	}
+
+	ingressClass := &networkingv1.IngressClass{}
+	if err := r.Get(ctx, types.NamespacedName{Name: *webApp.Spec.Ingress.IngressClassName}, ingressClass); err != nil {
 		if errors.IsNotFound(err) {
-			logger.Info("Creating a new Ingress", "Ingress.Namespace", desiredIngress.Namespace, "Ingress.Name", desiredIngress.Name)
-			err = r.Create(ctx, desiredIngress)
-			if err != nil {
-				return err
-			}
-			r.Recorder.Event(webApp, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created Ingress %s", desiredIngress.Name))
-			return nil
+			return nil, false, nil
 		}
+		return nil, false, err
+	}
+
+	translator, ok := ingresstranslate.Lookup(ingressClass.Spec.Controller)
+	return translator, ok, nil
+}
+
+// reconcileTranslatedObjects creates or updates the provider-specific
+// objects produced by a Translator, owned by the WebApp, stamping each with
+// specHashAnnotation and applying drifted ones via the same server-side
+// apply path applyDesired uses for the vanilla Ingress, rather than a plain
+// Update: these objects are often unstructured and were never fetched, so
+// they carry no resourceVersion, and without the hash annotation the drift
+// check always saw a mismatch and re-applied on every reconcile.
+func (r *WebAppReconciler) reconcileTranslatedObjects(ctx context.Context, webApp *webappv1.WebApp, translator ingresstranslate.Translator) error {
+	logger := log.FromContext(ctx)
+
+	objects, err := translator.Build(webApp)
+	if err != nil {
 		return err
 	}
 
-	// Update Ingress if host changed
-	if ingress.Spec.Rules[0].Host != desiredIngress.Spec.Rules[0].Host {
-		ingress.Spec = desiredIngress.Spec
-		err = r.Update(ctx, ingress)
+	for _, desired := range objects {
+		if err := controllerutil.SetControllerReference(webApp, desired, r.Scheme); err != nil {
+			return err
+		}
+
+		hash := hashObject(desired)
+		annotations := desired.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[specHashAnnotation] = hash
+		desired.SetAnnotations(annotations)
+
+		existing := desired.DeepCopyObject().(client.Object)
+		err := r.Get(ctx, types.NamespacedName{Name: desired.GetName(), Namespace: desired.GetNamespace()}, existing)
 		if err != nil {
+			if errors.IsNotFound(err) {
+				logger.Info("Creating translated Ingress object", "Kind", fmt.Sprintf("%T", desired), "Name", desired.GetName())
+				if err := r.Create(ctx, desired); err != nil {
+					return err
+				}
+				r.Recorder.Event(webApp, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %T %s", desired, desired.GetName()))
+				continue
+			}
+			return err
+		}
+
+		if existing.GetAnnotations()[specHashAnnotation] == hash {
+			continue
+		}
+
+		if err := r.Patch(ctx, desired, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership); err != nil {
 			return err
 		}
-		logger.Info("Updated Ingress", "Ingress.Namespace", ingress.Namespace, "Ingress.Name", ingress.Name)
-		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated Ingress %s", ingress.Name))
+		r.Recorder.Event(webApp, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %T %s", desired, desired.GetName()))
 	}
 
 	return nil
@@ -317,24 +905,65 @@ func (r *WebAppReconciler) deleteIngressIfExists(ctx context.Context, webApp *we
 	return nil
 }
 
+// rolloutStatusDeploymentNames returns the Deployment(s) updateStatus should
+// aggregate over for the WebApp's configured strategy: the single
+// Deployment for RollingUpdate, the currently active revision for
+// BlueGreen, or both revisions for Canary (whose traffic-serving pods are
+// split across "-stable" and "-canary", neither of which is named after the
+// WebApp itself).
+func rolloutStatusDeploymentNames(webApp *webappv1.WebApp) []string {
+	switch strategyType(webApp) {
+	case webappv1.CanaryStrategyType:
+		return []string{webApp.Name + stableSuffix, webApp.Name + canarySuffix}
+	case webappv1.BlueGreenStrategyType:
+		active, _ := activeAndPreviewNames(webApp)
+		return []string{active}
+	default:
+		return []string{webApp.Name}
+	}
+}
+
 func (r *WebAppReconciler) updateStatus(ctx context.Context, webApp *webappv1.WebApp) error {
 	logger := log.FromContext(ctx)
 
-	// Get the Deployment
-	deployment := &appsv1.Deployment{}
-	err := r.Get(ctx, types.NamespacedName{Name: webApp.Name, Namespace: webApp.Namespace}, deployment)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			return nil
+	// Aggregate Deployment status across every revision the current
+	// strategy manages.
+	var availableReplicas, readyReplicas, replicas, updatedReplicas, desiredReplicas int32
+	found := false
+	for _, name := range rolloutStatusDeploymentNames(webApp) {
+		deployment := &appsv1.Deployment{}
+		err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: webApp.Namespace}, deployment)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
 		}
-		return err
+		found = true
+		availableReplicas += deployment.Status.AvailableReplicas
+		readyReplicas += deployment.Status.ReadyReplicas
+		replicas += deployment.Status.Replicas
+		updatedReplicas += deployment.Status.UpdatedReplicas
+		if deployment.Spec.Replicas != nil {
+			desiredReplicas += *deployment.Spec.Replicas
+		}
+	}
+	if !found {
+		return nil
 	}
 
 	// Update status fields
 	status := webappv1.WebAppStatus{
-		AvailableReplicas: deployment.Status.AvailableReplicas,
-		ReadyReplicas:     deployment.Status.ReadyReplicas,
-		Replicas:          deployment.Status.Replicas,
+		AvailableReplicas: availableReplicas,
+		ReadyReplicas:     readyReplicas,
+		Replicas:          replicas,
+		ActiveService:     webApp.Status.ActiveService,
+		PreviewService:    webApp.Status.PreviewService,
+	}
+
+	if strategyType(webApp) == webappv1.BlueGreenStrategyType && status.ActiveService == "" {
+		status.ActiveService = webApp.Name
+		status.PreviewService = webApp.Name + previewSuffix
 	}
 
 	// Determine conditions
@@ -345,7 +974,7 @@ func (r *WebAppReconciler) updateStatus(ctx context.Context, webApp *webappv1.We
 		Type:               "Available",
 		LastTransitionTime: metav1.Now(),
 	}
-	if deployment.Status.AvailableReplicas >= *deployment.Spec.Replicas {
+	if availableReplicas >= desiredReplicas {
 		availableCondition.Status = metav1.ConditionTrue
 		availableCondition.Reason = "MinimumReplicasAvailable"
 		availableCondition.Message = "Deployment has minimum availability"
@@ -361,7 +990,7 @@ func (r *WebAppReconciler) updateStatus(ctx context.Context, webApp *webappv1.We
 		Type:               "Progressing",
 		LastTransitionTime: metav1.Now(),
 	}
-	if deployment.Status.UpdatedReplicas == *deployment.Spec.Replicas {
+	if updatedReplicas == desiredReplicas {
 		progressingCondition.Status = metav1.ConditionTrue
 		progressingCondition.Reason = "NewReplicaSetAvailable"
 		progressingCondition.Message = "Deployment has successfully progressed"
@@ -386,8 +1015,7 @@ func (r *WebAppReconciler) updateStatus(ctx context.Context, webApp *webappv1.We
 	// Update if changed
 	if !reflect.DeepEqual(webApp.Status, status) {
 		webApp.Status = status
-		err = r.Status().Update(ctx, webApp)
-		if err != nil {
+		if err := r.Status().Update(ctx, webApp); err != nil {
 			logger.Error(err, "Failed to update WebApp status")
 			return err
 		}
@@ -404,6 +1032,7 @@ func (r *WebAppReconciler) configMapForWebApp(webApp *webappv1.WebApp) *corev1.C
 	}
 
 	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      webApp.Name + "-config",
 			Namespace: webApp.Namespace,
@@ -479,6 +1108,7 @@ func (r *WebAppReconciler) deploymentForWebApp(webApp *webappv1.WebApp) *appsv1.
 	}
 
 	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      webApp.Name,
 			Namespace: webApp.Namespace,
@@ -569,6 +1199,29 @@ func (r *WebAppReconciler) deploymentForWebApp(webApp *webappv1.WebApp) *appsv1.
 	return deployment
 }
 
+// serviceSelectorForWebApp returns the pod selector the managed Service
+// should use. RollingUpdate and Canary both route through the base labels
+// alone: Canary's stable/canary Deployments each add a revisionLabel on top
+// of those base labels, so the selector still matches both and traffic
+// splits by relative replica count. BlueGreen instead scopes the selector
+// down to the active revision's revisionLabel value, so pods of the preview
+// Deployment never receive traffic before promotion.
+func serviceSelectorForWebApp(webApp *webappv1.WebApp) map[string]string {
+	labels := labelsForWebApp(webApp.Name)
+	if strategyType(webApp) != webappv1.BlueGreenStrategyType {
+		return labels
+	}
+
+	active, _ := activeAndPreviewNames(webApp)
+
+	selector := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		selector[k] = v
+	}
+	selector[revisionLabel] = active
+	return selector
+}
+
 func (r *WebAppReconciler) serviceForWebApp(webApp *webappv1.WebApp) *corev1.Service {
 	labels := labelsForWebApp(webApp.Name)
 
@@ -578,6 +1231,7 @@ func (r *WebAppReconciler) serviceForWebApp(webApp *webappv1.WebApp) *corev1.Ser
 	}
 
 	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      webApp.Name,
 			Namespace: webApp.Namespace,
@@ -585,7 +1239,7 @@ func (r *WebAppReconciler) serviceForWebApp(webApp *webappv1.WebApp) *corev1.Ser
 		},
 		Spec: corev1.ServiceSpec{
 			Type:     corev1.ServiceTypeClusterIP,
-			Selector: labels,
+			Selector: serviceSelectorForWebApp(webApp),
 			Ports: []corev1.ServicePort{{
 				Port:       port,
 				TargetPort: intstr.FromInt(int(port)),
@@ -602,53 +1256,113 @@ func (r *WebAppReconciler) serviceForWebApp(webApp *webappv1.WebApp) *corev1.Ser
 func (r *WebAppReconciler) ingressForWebApp(webApp *webappv1.WebApp) *networkingv1.Ingress {
 	labels := labelsForWebApp(webApp.Name)
 
-	port := defaultPort
-	if webApp.Spec.Port != 0 {
-		port = webApp.Spec.Port
+	annotations := make(map[string]string, len(webApp.Spec.Ingress.Annotations))
+	for k, v := range webApp.Spec.Ingress.Annotations {
+		annotations[k] = v
 	}
 
-	pathType := networkingv1.PathTypePrefix
-
 	ingress := &networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        webApp.Name,
 			Namespace:   webApp.Namespace,
 			Labels:      labels,
-			Annotations: webApp.Spec.Ingress.Annotations,
+			Annotations: annotations,
 		},
 		Spec: networkingv1.IngressSpec{
 			IngressClassName: webApp.Spec.Ingress.IngressClassName,
-			Rules: []networkingv1.IngressRule{{
-				Host: webApp.Spec.Ingress.Host,
-				IngressRuleValue: networkingv1.IngressRuleValue{
-					HTTP: &networkingv1.HTTPIngressRuleValue{
-						Paths: []networkingv1.HTTPIngressPath{{
-							Path:     "/",
-							PathType: &pathType,
-							Backend: networkingv1.IngressBackend{
-								Service: &networkingv1.IngressServiceBackend{
-									Name: webApp.Name,
-									Port: networkingv1.ServiceBackendPort{
-										Number: port,
-									},
-								},
-							},
-						}},
-					},
-				},
-			}},
+			Rules:            rulesForWebApp(webApp),
 		},
 	}
 
-	// Add TLS if configured
-	if webApp.Spec.Ingress.TLS != nil {
-		ingress.Spec.TLS = webApp.Spec.Ingress.TLS
+	for _, tls := range webApp.Spec.Ingress.TLS {
+		ingress.Spec.TLS = append(ingress.Spec.TLS, networkingv1.IngressTLS{
+			Hosts:      tls.Hosts,
+			SecretName: tls.SecretName,
+		})
 	}
 
 	controllerutil.SetControllerReference(webApp, ingress, r.Scheme)
 	return ingress
 }
 
+// rulesForWebApp builds one networkingv1.IngressRule per configured Rules
+// entry. For WebApps that still use the single Host/Port form (pre-dating
+// multi-host support), it synthesizes an equivalent single-rule, single-path
+// list so existing manifests keep working unchanged.
+func rulesForWebApp(webApp *webappv1.WebApp) []networkingv1.IngressRule {
+	if len(webApp.Spec.Ingress.Rules) == 0 {
+		port := defaultPort
+		if webApp.Spec.Port != 0 {
+			port = webApp.Spec.Port
+		}
+		pathType := networkingv1.PathTypePrefix
+		return []networkingv1.IngressRule{{
+			Host: webApp.Spec.Ingress.Host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: webApp.Name,
+								Port: networkingv1.ServiceBackendPort{Number: port},
+							},
+						},
+					}},
+				},
+			},
+		}}
+	}
+
+	rules := make([]networkingv1.IngressRule, 0, len(webApp.Spec.Ingress.Rules))
+	for _, rule := range webApp.Spec.Ingress.Rules {
+		paths := make([]networkingv1.HTTPIngressPath, 0, len(rule.Paths))
+		for _, path := range rule.Paths {
+			pathType := path.PathType
+			if pathType == nil {
+				prefix := networkingv1.PathTypePrefix
+				pathType = &prefix
+			}
+
+			// The Ingress API rejects a backend port that sets both Name
+			// and Number, so pick exactly one: a PortName always refers to
+			// a named container/Service port, which takes precedence over
+			// whatever numeric port also happens to be set.
+			backendPort := networkingv1.ServiceBackendPort{Number: path.ServiceBackendRef.Port}
+			if path.PortName != "" {
+				backendPort = networkingv1.ServiceBackendPort{Name: path.PortName}
+			}
+
+			backend := networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: webApp.Name,
+					Port: backendPort,
+				},
+			}
+			if path.ServiceBackendRef.Name != "" {
+				backend.Service.Name = path.ServiceBackendRef.Name
+			}
+
+			paths = append(paths, networkingv1.HTTPIngressPath{
+				Path:     path.Path,
+				PathType: pathType,
+				Backend:  backend,
+			})
+		}
+
+		rules = append(rules, networkingv1.IngressRule{
+			Host: rule.Host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{Paths: paths},
+			},
+		})
+	}
+
+	return rules
+}
+
 func labelsForWebApp(name string) map[string]string {
 	return map[string]string{
 		"app.kubernetes.io/name":       "webapp",
@@ -661,13 +1375,48 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Provider CRDs
+// (Traefik's IngressRoute, APISIX's ApisixRoute, ...) are only Owned when
+// their scheme is actually installed on the cluster, discovered once at
+// startup, so the operator doesn't fail to start against clusters that
+// don't run that particular ingress controller.
 func (r *WebAppReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	maxConcurrent := r.MaxConcurrentReconciles
+	if maxConcurrent == 0 {
+		maxConcurrent = runtime.NumCPU()
+	}
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
 		For(&webappv1.WebApp{}).
-		Owns(&appsv1.Deployment{}).
-		Owns(&corev1.Service{}).
-		Owns(&corev1.ConfigMap{}).
-		Owns(&networkingv1.Ingress{}).
-		Complete(r)
+		Owns(&appsv1.Deployment{}, builder.WithPredicates(childChangedPredicate)).
+		Owns(&corev1.Service{}, builder.WithPredicates(childChangedPredicate)).
+		Owns(&corev1.ConfigMap{}, builder.WithPredicates(childChangedPredicate)).
+		Owns(&networkingv1.Ingress{}, builder.WithPredicates(childChangedPredicate)).
+		Owns(&networkingv1.NetworkPolicy{}, builder.WithPredicates(childChangedPredicate)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxConcurrent,
+			RateLimiter:             newRateLimiter(),
+		})
+
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: "traefik.io", Version: "v1alpha1", Kind: "IngressRoute"},
+		{Group: "apisix.apache.org", Version: "v2", Kind: "ApisixRoute"},
+	} {
+		if _, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err == nil {
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(gvk)
+			bldr = bldr.Owns(u, builder.WithPredicates(childChangedPredicate))
+		}
+	}
+
+	return bldr.Complete(r)
+}
+
+// childChangedPredicate skips reconciling the owning WebApp when an owned
+// resource's ResourceVersion hasn't actually changed, filtering out the
+// no-op status-refresh events a no-change resync generates.
+var childChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+	},
 }
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+func newTestReconciler(t *testing.T, initObjs ...client.Object) *WebAppReconciler {
+	t.Helper()
+
+	s := k8sruntime.NewScheme()
+	if err := scheme.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+	if err := webappv1.AddToScheme(s); err != nil {
+		t.Fatalf("webappv1.AddToScheme: %v", err)
+	}
+
+	return &WebAppReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(s).WithObjects(initObjs...).Build(),
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(10),
+	}
+}
+
+func int32Ptr(i int32) *int32 { return &i }
+
+// TestApplyDeploymentOmitsReplicasOnUpdateWhenUnmanaged verifies that the
+// RollingUpdate path (manageReplicas=false) never reapplies spec.replicas to
+// an existing Deployment, so an HPA's last-written replica count survives a
+// reconcile triggered by an unrelated change (here, a new container image).
+func TestApplyDeploymentOmitsReplicasOnUpdateWhenUnmanaged(t *testing.T) {
+	webApp := &webappv1.WebApp{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+		Spec:       webappv1.WebAppSpec{Replicas: int32Ptr(2), Image: "nginx:1.22"},
+	}
+
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(7), // simulates an HPA having scaled this up
+			Selector: &metav1.LabelSelector{MatchLabels: labelsForWebApp("demo")},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labelsForWebApp("demo")},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "webapp", Image: "nginx:1.21"}}},
+			},
+		},
+	}
+
+	r := newTestReconciler(t, webApp, existing)
+
+	if err := r.reconcileRollingUpdate(context.Background(), webApp); err != nil {
+		t.Fatalf("reconcileRollingUpdate: %v", err)
+	}
+
+	got := &appsv1.Deployment{}
+	if err := r.Get(context.Background(), types.NamespacedName{Name: "demo", Namespace: "default"}, got); err != nil {
+		t.Fatalf("Get Deployment: %v", err)
+	}
+	if got.Spec.Replicas == nil || *got.Spec.Replicas != 7 {
+		t.Fatalf("expected HPA-set replicas 7 to survive the apply, got %v", got.Spec.Replicas)
+	}
+}
+
+// TestApplyDeploymentManagesReplicasForRevisions verifies the opposite case:
+// a Canary/BlueGreen revision (manageReplicas=true) always reapplies its
+// operator-computed replica count, since nothing else is allowed to own it.
+func TestApplyDeploymentManagesReplicasForRevisions(t *testing.T) {
+	webApp := &webappv1.WebApp{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+		Spec:       webappv1.WebAppSpec{Replicas: int32Ptr(4)},
+	}
+
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-canary", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(1),
+			Selector: &metav1.LabelSelector{MatchLabels: labelsForWebApp("demo")},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labelsForWebApp("demo")},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "webapp", Image: "nginx:1.21"}}},
+			},
+		},
+	}
+
+	r := newTestReconciler(t, webApp, existing)
+
+	if err := r.reconcileRevisionDeployment(context.Background(), webApp, "demo-canary", 3); err != nil {
+		t.Fatalf("reconcileRevisionDeployment: %v", err)
+	}
+
+	got := &appsv1.Deployment{}
+	if err := r.Get(context.Background(), types.NamespacedName{Name: "demo-canary", Namespace: "default"}, got); err != nil {
+		t.Fatalf("Get Deployment: %v", err)
+	}
+	if got.Spec.Replicas == nil || *got.Spec.Replicas != 3 {
+		t.Fatalf("expected operator-managed replicas to be reapplied to 3, got %v", got.Spec.Replicas)
+	}
+}
@@ -0,0 +1,294 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+// WebAppIngressReplicaReconciler reconciles a WebAppIngressReplica object.
+// A replica exposes an existing WebApp's Service under an additional
+// hostname/ingress controller, in a namespace of its own, without
+// duplicating the backing Deployment: it synthesizes a local ExternalName
+// Service pointing at the parent's ClusterIP Service and an Ingress
+// fronting that ExternalName Service.
+type WebAppIngressReplicaReconciler struct {
+	client.Client
+	Scheme   *k8sruntime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=webapp.example.com,resources=webappingressreplicas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=webapp.example.com,resources=webappingressreplicas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=webapp.example.com,resources=webapps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *WebAppIngressReplicaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	replica := &webappv1.WebAppIngressReplica{}
+	if err := r.Get(ctx, req.NamespacedName, replica); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	parentWebApp := &webappv1.WebApp{}
+	parentKey := types.NamespacedName{Name: replica.Spec.WebAppRef.Name, Namespace: replica.Spec.WebAppRef.Namespace}
+	if err := r.Get(ctx, parentKey, parentWebApp); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Parent WebApp not found, waiting", "WebAppRef.Namespace", parentKey.Namespace, "WebAppRef.Name", parentKey.Name)
+			return r.updateReplicaStatus(ctx, replica, false)
+		}
+		return ctrl.Result{}, err
+	}
+
+	parentService := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Name: parentWebApp.Name, Namespace: parentWebApp.Namespace}, parentService); err != nil {
+		if errors.IsNotFound(err) {
+			return r.updateReplicaStatus(ctx, replica, false)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileExternalNameService(ctx, replica, parentService); err != nil {
+		logger.Error(err, "Failed to reconcile replica Service")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileReplicaIngress(ctx, replica, parentWebApp, parentService); err != nil {
+		logger.Error(err, "Failed to reconcile replica Ingress")
+		return ctrl.Result{}, err
+	}
+
+	parentReady, err := r.parentEndpointsReady(ctx, parentService)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.updateReplicaStatus(ctx, replica, parentReady)
+}
+
+// parentEndpointsReady reports whether the parent Service has at least one
+// ready address, which is what actually determines whether traffic routed
+// through the replica's ExternalName Service would reach a live pod -
+// Status.ReadyReplicas can lag (or, for a Service not backed by a WebApp's
+// own Deployment, not exist at all).
+func (r *WebAppIngressReplicaReconciler) parentEndpointsReady(ctx context.Context, parentService *corev1.Service) (bool, error) {
+	endpoints := &corev1.Endpoints{}
+	key := types.NamespacedName{Name: parentService.Name, Namespace: parentService.Namespace}
+	if err := r.Get(ctx, key, endpoints); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *WebAppIngressReplicaReconciler) updateReplicaStatus(ctx context.Context, replica *webappv1.WebAppIngressReplica, parentReady bool) (ctrl.Result, error) {
+	if replica.Status.ParentReady == parentReady {
+		return ctrl.Result{}, nil
+	}
+	replica.Status.ParentReady = parentReady
+	if err := r.Status().Update(ctx, replica); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileExternalNameService creates or updates the ExternalName Service,
+// local to the replica's namespace, that resolves to the parent WebApp's
+// ClusterIP Service over cluster DNS.
+func (r *WebAppIngressReplicaReconciler) reconcileExternalNameService(ctx context.Context, replica *webappv1.WebAppIngressReplica, parentService *corev1.Service) error {
+	logger := log.FromContext(ctx)
+
+	externalName := fmt.Sprintf("%s.%s.svc.cluster.local", parentService.Name, parentService.Namespace)
+
+	desired := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      replica.Name,
+			Namespace: replica.Namespace,
+			Labels:    labelsForWebApp(replica.Name),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:         corev1.ServiceTypeExternalName,
+			ExternalName: externalName,
+			Ports:        parentService.Spec.Ports,
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(replica, desired, r.Scheme); err != nil {
+		return err
+	}
+
+	existing := &corev1.Service{}
+	err := r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Creating replica Service", "Service.Namespace", desired.Namespace, "Service.Name", desired.Name)
+			if err := r.Create(ctx, desired); err != nil {
+				return err
+			}
+			r.Recorder.Event(replica, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created Service %s", desired.Name))
+			return nil
+		}
+		return err
+	}
+
+	if existing.Spec.ExternalName != externalName || hashObject(existing.Spec.Ports) != hashObject(desired.Spec.Ports) {
+		existing.Spec.ExternalName = externalName
+		existing.Spec.Ports = parentService.Spec.Ports
+		if err := r.Update(ctx, existing); err != nil {
+			return err
+		}
+		r.Recorder.Event(replica, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated Service %s", existing.Name))
+	}
+
+	return nil
+}
+
+// reconcileReplicaIngress creates or updates the Ingress that fronts the
+// ExternalName Service under the replica's host (falling back to the
+// parent's host when no override is set) and IngressClassName.
+func (r *WebAppIngressReplicaReconciler) reconcileReplicaIngress(ctx context.Context, replica *webappv1.WebAppIngressReplica, parentWebApp *webappv1.WebApp, parentService *corev1.Service) error {
+	logger := log.FromContext(ctx)
+
+	if len(parentService.Spec.Ports) == 0 {
+		return fmt.Errorf("parent Service %s/%s has no ports", parentService.Namespace, parentService.Name)
+	}
+
+	host := replica.Spec.Host
+	if host == "" && parentWebApp.Spec.Ingress != nil {
+		host = parentWebApp.Spec.Ingress.Host
+	}
+	pathType := networkingv1.PathTypePrefix
+	port := parentService.Spec.Ports[0].Port
+
+	annotations := make(map[string]string, len(replica.Spec.Annotations))
+	for k, v := range replica.Spec.Annotations {
+		annotations[k] = v
+	}
+
+	desired := &networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        replica.Name,
+			Namespace:   replica.Namespace,
+			Labels:      labelsForWebApp(replica.Name),
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: replica.Spec.IngressClassName,
+			Rules: []networkingv1.IngressRule{{
+				Host: host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: replica.Name,
+									Port: networkingv1.ServiceBackendPort{Number: port},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(replica, desired, r.Scheme); err != nil {
+		return err
+	}
+
+	existing := &networkingv1.Ingress{}
+	err := r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Creating replica Ingress", "Ingress.Namespace", desired.Namespace, "Ingress.Name", desired.Name)
+			if err := r.Create(ctx, desired); err != nil {
+				return err
+			}
+			r.Recorder.Event(replica, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created Ingress %s", desired.Name))
+			return nil
+		}
+		return err
+	}
+
+	if hashObject(existing.Spec) != hashObject(desired.Spec) {
+		existing.Spec = desired.Spec
+		existing.Annotations = desired.Annotations
+		if err := r.Update(ctx, existing); err != nil {
+			return err
+		}
+		r.Recorder.Event(replica, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated Ingress %s", existing.Name))
+	}
+
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager, and requeues a
+// WebAppIngressReplica whenever the parent WebApp it references changes
+// (readiness flips, its Service ports change, and so on).
+func (r *WebAppIngressReplicaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&webappv1.WebAppIngressReplica{}).
+		Owns(&corev1.Service{}).
+		Owns(&networkingv1.Ingress{}).
+		Watches(&webappv1.WebApp{}, handler.EnqueueRequestsFromMapFunc(r.mapWebAppToReplicas)).
+		Complete(r)
+}
+
+// mapWebAppToReplicas lists every WebAppIngressReplica across the cluster
+// and enqueues the ones referencing the WebApp that triggered this event.
+// Replicas live in a different namespace than their parent by design, so
+// this can't be done with a simple owner-reference watch.
+func (r *WebAppIngressReplicaReconciler) mapWebAppToReplicas(ctx context.Context, obj client.Object) []ctrl.Request {
+	webApp, ok := obj.(*webappv1.WebApp)
+	if !ok {
+		return nil
+	}
+
+	var replicas webappv1.WebAppIngressReplicaList
+	if err := r.List(ctx, &replicas); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list WebAppIngressReplicas")
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, replica := range replicas.Items {
+		if replica.Spec.WebAppRef.Name == webApp.Name && replica.Spec.WebAppRef.Namespace == webApp.Namespace {
+			requests = append(requests, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: replica.Name, Namespace: replica.Namespace},
+			})
+		}
+	}
+	return requests
+}
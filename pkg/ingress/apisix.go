@@ -0,0 +1,62 @@
+package ingress
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+const apisixController = "apisix.apache.org/apisix-ingress-controller"
+
+func init() {
+	Register(apisixController, apisixTranslator{})
+}
+
+// apisixTranslator emits an apisix.apache.org/v2 ApisixRoute (via the
+// unstructured client) with one http block per host/path pair. Canary
+// weighting already happens at the pod level (reconcileCanary sizes the
+// stable/canary Deployments), and both revisions sit behind the single
+// Service the operator creates, so every block targets that Service.
+type apisixTranslator struct{}
+
+func (apisixTranslator) Build(webApp *webappv1.WebApp) ([]client.Object, error) {
+	httpBlocks := []interface{}{}
+	i := 0
+	for _, rule := range httpRules(webApp) {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			i++
+			httpBlocks = append(httpBlocks, map[string]interface{}{
+				"name": fmt.Sprintf("%s-%d", webApp.Name, i),
+				"match": map[string]interface{}{
+					"hosts": []interface{}{rule.Host},
+					"paths": []interface{}{path.Path + "*"},
+				},
+				"backends": []interface{}{
+					map[string]interface{}{"serviceName": webApp.Name, "servicePort": path.Backend.Service.Port.Number},
+				},
+			})
+		}
+	}
+
+	route := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apisix.apache.org/v2",
+			"kind":       "ApisixRoute",
+			"metadata": map[string]interface{}{
+				"name":      webApp.Name,
+				"namespace": webApp.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"http": httpBlocks,
+			},
+		},
+	}
+
+	return []client.Object{route}, nil
+}
@@ -0,0 +1,76 @@
+package ingress
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+// httpRules mirrors the vanilla-Ingress rule building the controller falls
+// back to, so provider translators share the same host/path/backend
+// resolution instead of each re-deriving it from the WebApp spec.
+func httpRules(webApp *webappv1.WebApp) []networkingv1.IngressRule {
+	if webApp.Spec.Ingress == nil {
+		return nil
+	}
+
+	if len(webApp.Spec.Ingress.Rules) == 0 {
+		port := webApp.Spec.Port
+		if port == 0 {
+			port = 80
+		}
+		pathType := networkingv1.PathTypePrefix
+		return []networkingv1.IngressRule{{
+			Host: webApp.Spec.Ingress.Host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: webApp.Name,
+								Port: networkingv1.ServiceBackendPort{Number: port},
+							},
+						},
+					}},
+				},
+			},
+		}}
+	}
+
+	rules := make([]networkingv1.IngressRule, 0, len(webApp.Spec.Ingress.Rules))
+	for _, rule := range webApp.Spec.Ingress.Rules {
+		paths := make([]networkingv1.HTTPIngressPath, 0, len(rule.Paths))
+		for _, path := range rule.Paths {
+			pathType := path.PathType
+			if pathType == nil {
+				prefix := networkingv1.PathTypePrefix
+				pathType = &prefix
+			}
+			backendName := webApp.Name
+			if path.ServiceBackendRef.Name != "" {
+				backendName = path.ServiceBackendRef.Name
+			}
+			port := networkingv1.ServiceBackendPort{Number: path.ServiceBackendRef.Port}
+			if path.PortName != "" {
+				port = networkingv1.ServiceBackendPort{Name: path.PortName}
+			}
+			paths = append(paths, networkingv1.HTTPIngressPath{
+				Path:     path.Path,
+				PathType: pathType,
+				Backend: networkingv1.IngressBackend{
+					Service: &networkingv1.IngressServiceBackend{
+						Name: backendName,
+						Port: port,
+					},
+				},
+			})
+		}
+		rules = append(rules, networkingv1.IngressRule{
+			Host:             rule.Host,
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{Paths: paths}},
+		})
+	}
+	return rules
+}
@@ -0,0 +1,43 @@
+package ingress
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+const haproxyController = "haproxy-ingress.github.io/controller"
+
+func init() {
+	Register(haproxyController, haproxyTranslator{})
+}
+
+// haproxyTranslator emits a vanilla Ingress using haproxy-ingress's
+// annotation convention for sticky sessions (cookie affinity). It sets no
+// path-rewrite annotation.
+type haproxyTranslator struct{}
+
+func (haproxyTranslator) Build(webApp *webappv1.WebApp) ([]client.Object, error) {
+	annotations := map[string]string{}
+	for k, v := range webApp.Spec.Ingress.Annotations {
+		annotations[k] = v
+	}
+	annotations["haproxy-ingress.github.io/affinity"] = "cookie"
+
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        webApp.Name,
+			Namespace:   webApp.Namespace,
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: webApp.Spec.Ingress.IngressClassName,
+			Rules:            httpRules(webApp),
+		},
+	}
+
+	return []client.Object{ing}, nil
+}
@@ -0,0 +1,45 @@
+package ingress
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+const nginxController = "k8s.io/ingress-nginx"
+
+func init() {
+	Register(nginxController, nginxTranslator{})
+}
+
+// nginxTranslator emits a vanilla networking.k8s.io/v1 Ingress. Canary
+// weighting already happens at the pod level (reconcileCanary sizes the
+// stable/canary Deployments), and both revisions sit behind the single
+// Service the operator creates, so this doesn't also set ingress-nginx's
+// canary annotations: a lone canary Ingress with no sibling primary Ingress
+// for the same host is invalid and ingress-nginx won't serve it.
+type nginxTranslator struct{}
+
+func (nginxTranslator) Build(webApp *webappv1.WebApp) ([]client.Object, error) {
+	annotations := map[string]string{}
+	for k, v := range webApp.Spec.Ingress.Annotations {
+		annotations[k] = v
+	}
+
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        webApp.Name,
+			Namespace:   webApp.Namespace,
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: webApp.Spec.Ingress.IngressClassName,
+			Rules:            httpRules(webApp),
+		},
+	}
+
+	return []client.Object{ing}, nil
+}
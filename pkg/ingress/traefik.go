@@ -0,0 +1,59 @@
+package ingress
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+const traefikController = "traefik.io/ingress-controller"
+
+func init() {
+	Register(traefikController, traefikTranslator{})
+}
+
+// traefikTranslator emits a traefik.io/v1alpha1 IngressRoute (via the
+// unstructured client, to avoid a hard dependency on Traefik's generated
+// clientset) with one route per host/path pair. Canary weighting already
+// happens at the pod level (reconcileCanary sizes the stable/canary
+// Deployments), and both revisions sit behind the single Service the
+// operator creates, so every route simply targets that Service.
+type traefikTranslator struct{}
+
+func (traefikTranslator) Build(webApp *webappv1.WebApp) ([]client.Object, error) {
+	routes := []interface{}{}
+	for _, rule := range httpRules(webApp) {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			routes = append(routes, map[string]interface{}{
+				"match": fmt.Sprintf("Host(`%s`) && PathPrefix(`%s`)", rule.Host, path.Path),
+				"kind":  "Rule",
+				"services": []interface{}{
+					map[string]interface{}{"name": webApp.Name, "port": path.Backend.Service.Port.Number},
+				},
+			})
+		}
+	}
+
+	route := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "traefik.io/v1alpha1",
+			"kind":       "IngressRoute",
+			"metadata": map[string]interface{}{
+				"name":      webApp.Name,
+				"namespace": webApp.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"entryPoints": []interface{}{"websecure"},
+				"routes":      routes,
+			},
+		},
+	}
+
+	return []client.Object{route}, nil
+}
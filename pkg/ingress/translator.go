@@ -0,0 +1,43 @@
+// Package ingress translates a WebApp's generic ingress intent into the
+// provider-specific resources understood by whatever Ingress controller is
+// actually installed on the cluster. The controller looks up the
+// IngressClass referenced by Spec.Ingress.IngressClassName, reads its
+// Spec.Controller string, and dispatches to the Translator registered for
+// that controller; when none is registered it falls back to emitting a
+// vanilla networking.k8s.io/v1 Ingress.
+package ingress
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	webappv1 "github.com/example/webapp-operator/api/v1"
+)
+
+// Translator builds the objects needed to expose a WebApp under a specific
+// Ingress controller, as that controller's preferred annotations or CRDs.
+// The translators in this package carry over the WebApp's hosts, paths and
+// annotations; only haproxy adds a sticky-session annotation, and none of
+// them emits rate-limit, path-rewrite or canary-weight settings.
+type Translator interface {
+	// Build returns the client.Objects that must exist for the WebApp to be
+	// reachable through this translator's Ingress controller. Objects are
+	// returned unsaved; the caller is responsible for setting the owner
+	// reference and creating/updating them.
+	Build(webApp *webappv1.WebApp) ([]client.Object, error)
+}
+
+var registry = map[string]Translator{}
+
+// Register associates a Translator with the IngressClass controller string
+// it handles, e.g. "traefik.io/ingress-controller". Provider packages call
+// this from an init function.
+func Register(controller string, t Translator) {
+	registry[controller] = t
+}
+
+// Lookup returns the Translator registered for the given IngressClass
+// controller string, and false if none was registered.
+func Lookup(controller string) (Translator, bool) {
+	t, ok := registry[controller]
+	return t, ok
+}